@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsGlobalAcceleratorAccelerator() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsGlobalAcceleratorAcceleratorRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"ip_address_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hosted_zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_addresses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ip_family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"attributes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flow_logs_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"flow_logs_s3_bucket": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"flow_logs_s3_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsGlobalAcceleratorAcceleratorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	var accelerator *globalaccelerator.Accelerator
+
+	if v, ok := d.GetOk("arn"); ok {
+		arn := v.(string)
+
+		acc, err := resourceAwsGlobalAcceleratorAcceleratorRetrieve(conn, arn)
+		if err != nil {
+			return fmt.Errorf("Error reading Global Accelerator accelerator (%s): %s", arn, err)
+		}
+
+		if acc == nil {
+			return fmt.Errorf("No Global Accelerator accelerator found with arn: %s", arn)
+		}
+
+		accelerator = acc
+	} else if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+
+		acc, err := dataSourceAwsGlobalAcceleratorAcceleratorFindByName(conn, name)
+		if err != nil {
+			return err
+		}
+
+		if acc == nil {
+			return fmt.Errorf("No Global Accelerator accelerator found with name: %s", name)
+		}
+
+		accelerator = acc
+	} else {
+		return fmt.Errorf("Either `arn` or `name` must be specified")
+	}
+
+	d.SetId(aws.StringValue(accelerator.AcceleratorArn))
+	d.Set("arn", accelerator.AcceleratorArn)
+	d.Set("name", accelerator.Name)
+	d.Set("ip_address_type", accelerator.IpAddressType)
+	d.Set("enabled", accelerator.Enabled)
+	d.Set("dns_name", accelerator.DnsName)
+	d.Set("hosted_zone_id", route53HostedZoneIDForGlobalAccelerator)
+	d.Set("ip_sets", resourceAwsGlobalAcceleratorAcceleratorFlattenIpSets(accelerator.IpSets))
+
+	resp, err := conn.DescribeAcceleratorAttributes(&globalaccelerator.DescribeAcceleratorAttributesInput{
+		AcceleratorArn: accelerator.AcceleratorArn,
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading Global Accelerator accelerator attributes: %s", err)
+	}
+
+	d.Set("attributes", resourceAwsGlobalAcceleratorAcceleratorFlattenAttributes(resp.AcceleratorAttributes))
+
+	return nil
+}
+
+func dataSourceAwsGlobalAcceleratorAcceleratorFindByName(conn *globalaccelerator.GlobalAccelerator, name string) (*globalaccelerator.Accelerator, error) {
+	var found *globalaccelerator.Accelerator
+
+	input := &globalaccelerator.ListAcceleratorsInput{}
+	err := conn.ListAcceleratorsPages(input, func(page *globalaccelerator.ListAcceleratorsOutput, lastPage bool) bool {
+		for _, accelerator := range page.Accelerators {
+			if aws.StringValue(accelerator.Name) == name {
+				found = accelerator
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Global Accelerator accelerators: %s", err)
+	}
+
+	return found, nil
+}
@@ -0,0 +1,44 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsGlobalAcceleratorAccelerator_basic(t *testing.T) {
+	resourceName := "aws_globalaccelerator_accelerator.example"
+	datasourceName := "data.aws_globalaccelerator_accelerator.example"
+	rName := fmt.Sprintf("tf-testacc-ga-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsGlobalAcceleratorAcceleratorConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "name", resourceName, "name"),
+					resource.TestMatchResourceAttr(datasourceName, "dns_name", regexp.MustCompile(`\.awsglobalaccelerator\.com$`)),
+					resource.TestCheckResourceAttr(datasourceName, "hosted_zone_id", "Z2BJ6XQ5FK7U4H"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsGlobalAcceleratorAcceleratorConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_accelerator" "example" {
+  name = %[1]q
+}
+
+data "aws_globalaccelerator_accelerator" "example" {
+  arn = aws_globalaccelerator_accelerator.example.id
+}
+`, rName)
+}
@@ -1,18 +1,32 @@
 package aws
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/s3"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// route53HostedZoneIDForGlobalAccelerator is the Route 53 hosted zone ID
+// used when creating alias records that point at a Global Accelerator
+// accelerator's DNS name. This value is the same for all accounts and
+// regions. See https://docs.aws.amazon.com/general/latest/gr/global_accelerator.html
+const route53HostedZoneIDForGlobalAccelerator = "Z2BJ6XQ5FK7U4H"
+
+// globalAcceleratorFlowLogsBucketPolicySid identifies the bucket policy
+// statement managed by resourceAwsGlobalAcceleratorAcceleratorBootstrapFlowLogsBucketPolicy,
+// so it can be found and replaced on subsequent applies instead of duplicated.
+const globalAcceleratorFlowLogsBucketPolicySid = "GlobalAcceleratorFlowLogsWrite"
+
 func resourceAwsGlobalAcceleratorAccelerator() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsGlobalAcceleratorAcceleratorCreate,
@@ -40,6 +54,7 @@ func resourceAwsGlobalAcceleratorAccelerator() *schema.Resource {
 				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					globalaccelerator.IpAddressTypeIpv4,
+					globalaccelerator.IpAddressTypeDualStack,
 				}, false),
 			},
 			"enabled": {
@@ -47,6 +62,14 @@ func resourceAwsGlobalAcceleratorAccelerator() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hosted_zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"ip_sets": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -92,13 +115,35 @@ func resourceAwsGlobalAcceleratorAccelerator() *schema.Resource {
 							Optional: true,
 							Computed: true,
 						},
+						"flow_logs_create_bucket_policy": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
+			"tags": tagsSchema(),
 		},
+
+		CustomizeDiff: resourceAwsGlobalAcceleratorAcceleratorCustomizeDiff,
 	}
 }
 
+func resourceAwsGlobalAcceleratorAcceleratorCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	attrs, ok := diff.Get("attributes").([]interface{})
+	if !ok || len(attrs) == 0 {
+		return nil
+	}
+
+	attr := attrs[0].(map[string]interface{})
+	if attr["flow_logs_enabled"].(bool) && attr["flow_logs_s3_bucket"].(string) == "" {
+		return fmt.Errorf("attributes.0.flow_logs_s3_bucket must be set when attributes.0.flow_logs_enabled is true")
+	}
+
+	return nil
+}
+
 func resourceAwsGlobalAcceleratorAcceleratorCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).globalacceleratorconn
 
@@ -135,12 +180,22 @@ func resourceAwsGlobalAcceleratorAcceleratorCreate(d *schema.ResourceData, meta
 	}
 
 	if v := d.Get("attributes").([]interface{}); len(v) > 0 {
-		err = resourceAwsGlobalAcceleratorAcceleratorUpdateAttributes(conn, d.Id(), v[0].(map[string]interface{}))
+		err = resourceAwsGlobalAcceleratorAcceleratorUpdateAttributes(meta, d.Id(), v[0].(map[string]interface{}))
 		if err != nil {
 			return err
 		}
 	}
 
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		_, err := conn.TagResource(&globalaccelerator.TagResourceInput{
+			ResourceArn: aws.String(d.Id()),
+			Tags:        tagsFromMapGlobalAccelerator(v),
+		})
+		if err != nil {
+			return fmt.Errorf("Error tagging Global Accelerator accelerator (%s): %s", d.Id(), err)
+		}
+	}
+
 	return resourceAwsGlobalAcceleratorAcceleratorRead(d, meta)
 }
 
@@ -161,6 +216,8 @@ func resourceAwsGlobalAcceleratorAcceleratorRead(d *schema.ResourceData, meta in
 	d.Set("name", accelerator.Name)
 	d.Set("ip_address_type", accelerator.IpAddressType)
 	d.Set("enabled", accelerator.Enabled)
+	d.Set("dns_name", accelerator.DnsName)
+	d.Set("hosted_zone_id", route53HostedZoneIDForGlobalAccelerator)
 	d.Set("ip_sets", resourceAwsGlobalAcceleratorAcceleratorFlattenIpSets(accelerator.IpSets))
 
 	resp, err := conn.DescribeAcceleratorAttributes(&globalaccelerator.DescribeAcceleratorAttributesInput{
@@ -171,7 +228,30 @@ func resourceAwsGlobalAcceleratorAcceleratorRead(d *schema.ResourceData, meta in
 		return fmt.Errorf("Error reading Global Accelerator accelerator attributes: %s", err)
 	}
 
-	d.Set("attributes", resourceAwsGlobalAcceleratorAcceleratorFlattenAttributes(resp.AcceleratorAttributes))
+	attributes := resourceAwsGlobalAcceleratorAcceleratorFlattenAttributes(resp.AcceleratorAttributes)
+
+	// flow_logs_create_bucket_policy is a local-only directive: the
+	// DescribeAcceleratorAttributes API has no concept of it, so carry the
+	// previously configured value forward instead of losing it on refresh.
+	if oldAttrs, ok := d.Get("attributes").([]interface{}); ok && len(oldAttrs) > 0 && len(attributes) > 0 {
+		if oldAttr, ok := oldAttrs[0].(map[string]interface{}); ok {
+			attributes[0].(map[string]interface{})["flow_logs_create_bucket_policy"] = oldAttr["flow_logs_create_bucket_policy"]
+		}
+	}
+
+	d.Set("attributes", attributes)
+
+	tagsResp, err := conn.ListTagsForResource(&globalaccelerator.ListTagsForResourceInput{
+		ResourceArn: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error reading Global Accelerator accelerator tags: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapGlobalAccelerator(tagsResp.Tags)); err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
 
 	return nil
 }
@@ -285,7 +365,7 @@ func resourceAwsGlobalAcceleratorAcceleratorUpdate(d *schema.ResourceData, meta
 
 	if d.HasChange("attributes") {
 		if v := d.Get("attributes").([]interface{}); len(v) > 0 {
-			err := resourceAwsGlobalAcceleratorAcceleratorUpdateAttributes(conn, d.Id(), v[0].(map[string]interface{}))
+			err := resourceAwsGlobalAcceleratorAcceleratorUpdateAttributes(meta, d.Id(), v[0].(map[string]interface{}))
 			if err != nil {
 				return err
 			}
@@ -295,12 +375,24 @@ func resourceAwsGlobalAcceleratorAcceleratorUpdate(d *schema.ResourceData, meta
 		d.SetPartial("attributes")
 	}
 
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		if err := resourceAwsGlobalAcceleratorAcceleratorUpdateTags(conn, d.Id(), o.(map[string]interface{}), n.(map[string]interface{})); err != nil {
+			return err
+		}
+
+		d.SetPartial("tags")
+	}
+
 	d.Partial(false)
 
 	return resourceAwsGlobalAcceleratorAcceleratorRead(d, meta)
 }
 
-func resourceAwsGlobalAcceleratorAcceleratorUpdateAttributes(conn *globalaccelerator.GlobalAccelerator, acceleratorArn string, attributes map[string]interface{}) error {
+func resourceAwsGlobalAcceleratorAcceleratorUpdateAttributes(meta interface{}, acceleratorArn string, attributes map[string]interface{}) error {
+	client := meta.(*AWSClient)
+	conn := client.globalacceleratorconn
+
 	opts := &globalaccelerator.UpdateAcceleratorAttributesInput{
 		AcceleratorArn:  aws.String(acceleratorArn),
 		FlowLogsEnabled: aws.Bool(attributes["flow_logs_enabled"].(bool)),
@@ -314,6 +406,15 @@ func resourceAwsGlobalAcceleratorAcceleratorUpdateAttributes(conn *globalacceler
 		opts.FlowLogsS3Prefix = aws.String(v.(string))
 	}
 
+	if attributes["flow_logs_enabled"].(bool) && attributes["flow_logs_create_bucket_policy"].(bool) {
+		bucket := attributes["flow_logs_s3_bucket"].(string)
+		prefix := attributes["flow_logs_s3_prefix"].(string)
+
+		if err := resourceAwsGlobalAcceleratorAcceleratorBootstrapFlowLogsBucketPolicy(client.s3conn, client.partition, bucket, prefix); err != nil {
+			return fmt.Errorf("Error bootstrapping flow logs S3 bucket policy for %s: %s", bucket, err)
+		}
+	}
+
 	log.Printf("[DEBUG] Update Global Accelerator accelerator attributes: %s", opts)
 
 	_, err := conn.UpdateAcceleratorAttributes(opts)
@@ -324,6 +425,129 @@ func resourceAwsGlobalAcceleratorAcceleratorUpdateAttributes(conn *globalacceler
 	return nil
 }
 
+// resourceAwsGlobalAcceleratorAcceleratorBootstrapFlowLogsBucketPolicy grants
+// globalaccelerator.amazonaws.com permission to write flow logs into the
+// configured bucket/prefix, merging the required statement into whatever
+// bucket policy (if any) is already in place.
+func resourceAwsGlobalAcceleratorAcceleratorBootstrapFlowLogsBucketPolicy(s3conn *s3.S3, partition, bucket, prefix string) error {
+	policy := &IAMPolicyDoc{
+		Version: "2012-10-17",
+	}
+
+	resp, err := s3conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	})
+
+	if err != nil && !isAWSErr(err, "NoSuchBucketPolicy", "") {
+		return fmt.Errorf("Error reading S3 bucket policy for %s: %s", bucket, err)
+	}
+
+	if resp != nil && resp.Policy != nil {
+		if err := json.Unmarshal([]byte(*resp.Policy), policy); err != nil {
+			return fmt.Errorf("Error parsing S3 bucket policy for %s: %s", bucket, err)
+		}
+	}
+
+	resourceArn := fmt.Sprintf("arn:%s:s3:::%s/AWSLogs/*", partition, bucket)
+	if p := strings.Trim(prefix, "/"); p != "" {
+		resourceArn = fmt.Sprintf("arn:%s:s3:::%s/%s/AWSLogs/*", partition, bucket, p)
+	}
+
+	// Replace any statement previously added under
+	// globalAcceleratorFlowLogsBucketPolicySid (by this or any other
+	// accelerator) instead of appending, so repeated applies stay idempotent
+	// rather than piling up duplicate (and likely rejected) Sid entries.
+	statements := make([]*IAMPolicyStatement, 0, len(policy.Statements)+1)
+	for _, s := range policy.Statements {
+		if s.Sid != globalAcceleratorFlowLogsBucketPolicySid {
+			statements = append(statements, s)
+		}
+	}
+
+	policy.Statements = append(statements, &IAMPolicyStatement{
+		Sid:    globalAcceleratorFlowLogsBucketPolicySid,
+		Effect: "Allow",
+		Principal: map[string]string{
+			"Service": "globalaccelerator.amazonaws.com",
+		},
+		Actions:   []string{"s3:PutObject"},
+		Resources: []string{resourceArn},
+	})
+
+	policyBytes, err := json.Marshal(policy)
+	if err != nil {
+		return fmt.Errorf("Error marshaling S3 bucket policy for %s: %s", bucket, err)
+	}
+
+	_, err = s3conn.PutBucketPolicy(&s3.PutBucketPolicyInput{
+		Bucket: aws.String(bucket),
+		Policy: aws.String(string(policyBytes)),
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating S3 bucket policy for %s: %s", bucket, err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlobalAcceleratorAcceleratorUpdateTags(conn *globalaccelerator.GlobalAccelerator, acceleratorArn string, oldTags, newTags map[string]interface{}) error {
+	create, remove := diffTags(tagsFromMap(oldTags), tagsFromMap(newTags))
+
+	if len(remove) > 0 {
+		removeKeys := make([]*string, len(remove))
+		for i, t := range remove {
+			removeKeys[i] = t.Key
+		}
+
+		log.Printf("[DEBUG] Untag Global Accelerator accelerator: %s", removeKeys)
+
+		_, err := conn.UntagResource(&globalaccelerator.UntagResourceInput{
+			ResourceArn: aws.String(acceleratorArn),
+			TagKeys:     removeKeys,
+		})
+		if err != nil {
+			return fmt.Errorf("Error untagging Global Accelerator accelerator (%s): %s", acceleratorArn, err)
+		}
+	}
+
+	if len(create) > 0 {
+		log.Printf("[DEBUG] Tag Global Accelerator accelerator: %s", create)
+
+		_, err := conn.TagResource(&globalaccelerator.TagResourceInput{
+			ResourceArn: aws.String(acceleratorArn),
+			Tags:        tagsFromMapGlobalAccelerator(newTags),
+		})
+		if err != nil {
+			return fmt.Errorf("Error tagging Global Accelerator accelerator (%s): %s", acceleratorArn, err)
+		}
+	}
+
+	return nil
+}
+
+func tagsFromMapGlobalAccelerator(m map[string]interface{}) []*globalaccelerator.Tag {
+	tags := make([]*globalaccelerator.Tag, 0, len(m))
+
+	for k, v := range m {
+		tags = append(tags, &globalaccelerator.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return tags
+}
+
+func tagsToMapGlobalAccelerator(ts []*globalaccelerator.Tag) map[string]string {
+	result := make(map[string]string)
+
+	for _, t := range ts {
+		result[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return result
+}
+
 func resourceAwsGlobalAcceleratorAcceleratorDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).globalacceleratorconn
 
@@ -0,0 +1,299 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlobalAcceleratorAccelerator_basic(t *testing.T) {
+	var accelerator globalaccelerator.Accelerator
+	resourceName := "aws_globalaccelerator_accelerator.example"
+	rName := fmt.Sprintf("tf-testacc-ga-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlobalAcceleratorAcceleratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorAcceleratorConfig_ipAddressType(rName, globalaccelerator.IpAddressTypeIpv4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorAcceleratorExists(resourceName, &accelerator),
+					resource.TestMatchResourceAttr(resourceName, "dns_name", regexp.MustCompile(`\.awsglobalaccelerator\.com$`)),
+					resource.TestCheckResourceAttr(resourceName, "hosted_zone_id", "Z2BJ6XQ5FK7U4H"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlobalAcceleratorAccelerator_ipAddressType(t *testing.T) {
+	var accelerator globalaccelerator.Accelerator
+	resourceName := "aws_globalaccelerator_accelerator.example"
+	rName := fmt.Sprintf("tf-testacc-ga-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlobalAcceleratorAcceleratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorAcceleratorConfig_ipAddressType(rName, globalaccelerator.IpAddressTypeIpv4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorAcceleratorExists(resourceName, &accelerator),
+					resource.TestCheckResourceAttr(resourceName, "ip_address_type", globalaccelerator.IpAddressTypeIpv4),
+					resource.TestCheckResourceAttr(resourceName, "ip_sets.#", "1"),
+				),
+			},
+			{
+				Config: testAccGlobalAcceleratorAcceleratorConfig_ipAddressType(rName, globalaccelerator.IpAddressTypeDualStack),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorAcceleratorExists(resourceName, &accelerator),
+					resource.TestCheckResourceAttr(resourceName, "ip_address_type", globalaccelerator.IpAddressTypeDualStack),
+					resource.TestCheckResourceAttr(resourceName, "ip_sets.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlobalAcceleratorAccelerator_flowLogsRequiresBucket(t *testing.T) {
+	rName := fmt.Sprintf("tf-testacc-ga-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlobalAcceleratorAcceleratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGlobalAcceleratorAcceleratorConfig_flowLogsNoBucket(rName),
+				ExpectError: regexp.MustCompile(`flow_logs_s3_bucket must be set`),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlobalAcceleratorAccelerator_flowLogsCreateBucketPolicy(t *testing.T) {
+	var accelerator globalaccelerator.Accelerator
+	resourceName := "aws_globalaccelerator_accelerator.example"
+	bucketResourceName := "aws_s3_bucket.example"
+	rName := fmt.Sprintf("tf-testacc-ga-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlobalAcceleratorAcceleratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorAcceleratorConfig_flowLogsCreateBucketPolicy(rName, "flow-logs"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorAcceleratorExists(resourceName, &accelerator),
+					testAccCheckGlobalAcceleratorFlowLogsBucketPolicyStatementCount(bucketResourceName, 1),
+				),
+			},
+			{
+				// Changing an unrelated nested attribute (prefix) while flow
+				// logs stay enabled re-runs UpdateAcceleratorAttributes; the
+				// bootstrapped statement must be replaced, not duplicated.
+				Config: testAccGlobalAcceleratorAcceleratorConfig_flowLogsCreateBucketPolicy(rName, "flow-logs-v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorAcceleratorExists(resourceName, &accelerator),
+					testAccCheckGlobalAcceleratorFlowLogsBucketPolicyStatementCount(bucketResourceName, 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGlobalAcceleratorFlowLogsBucketPolicyStatementCount(bucketResourceName string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[bucketResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", bucketResourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+		resp, err := conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return fmt.Errorf("Error reading S3 bucket policy: %s", err)
+		}
+
+		var policy IAMPolicyDoc
+		if err := json.Unmarshal([]byte(aws.StringValue(resp.Policy)), &policy); err != nil {
+			return fmt.Errorf("Error parsing S3 bucket policy: %s", err)
+		}
+
+		got := 0
+		for _, statement := range policy.Statements {
+			if statement.Sid == globalAcceleratorFlowLogsBucketPolicySid {
+				got++
+			}
+		}
+
+		if got != want {
+			return fmt.Errorf("expected %d %s statement(s) in bucket policy, got %d", want, globalAcceleratorFlowLogsBucketPolicySid, got)
+		}
+
+		return nil
+	}
+}
+
+func TestAccAWSGlobalAcceleratorAccelerator_tags(t *testing.T) {
+	var accelerator globalaccelerator.Accelerator
+	resourceName := "aws_globalaccelerator_accelerator.example"
+	rName := fmt.Sprintf("tf-testacc-ga-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlobalAcceleratorAcceleratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorAcceleratorConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorAcceleratorExists(resourceName, &accelerator),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccGlobalAcceleratorAcceleratorConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorAcceleratorExists(resourceName, &accelerator),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccGlobalAcceleratorAcceleratorConfig_ipAddressType(rName, globalaccelerator.IpAddressTypeIpv4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorAcceleratorExists(resourceName, &accelerator),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGlobalAcceleratorAcceleratorExists(name string, accelerator *globalaccelerator.Accelerator) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+		resp, err := resourceAwsGlobalAcceleratorAcceleratorRetrieve(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp == nil {
+			return fmt.Errorf("Global Accelerator accelerator not found")
+		}
+
+		*accelerator = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckGlobalAcceleratorAcceleratorDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_globalaccelerator_accelerator" {
+			continue
+		}
+
+		accelerator, err := resourceAwsGlobalAcceleratorAcceleratorRetrieve(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if accelerator != nil {
+			return fmt.Errorf("Global Accelerator accelerator still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccGlobalAcceleratorAcceleratorConfig_ipAddressType(rName, ipAddressType string) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_accelerator" "example" {
+  name            = %[1]q
+  ip_address_type = %[2]q
+  enabled         = false
+}
+`, rName, ipAddressType)
+}
+
+func testAccGlobalAcceleratorAcceleratorConfig_flowLogsNoBucket(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_accelerator" "example" {
+  name = %[1]q
+
+  attributes {
+    flow_logs_enabled = true
+  }
+}
+`, rName)
+}
+
+func testAccGlobalAcceleratorAcceleratorConfig_flowLogsCreateBucketPolicy(rName, prefix string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "example" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_globalaccelerator_accelerator" "example" {
+  name = %[1]q
+
+  attributes {
+    flow_logs_enabled               = true
+    flow_logs_s3_bucket             = aws_s3_bucket.example.bucket
+    flow_logs_s3_prefix             = %[2]q
+    flow_logs_create_bucket_policy  = true
+  }
+}
+`, rName, prefix)
+}
+
+func testAccGlobalAcceleratorAcceleratorConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_accelerator" "example" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccGlobalAcceleratorAcceleratorConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_accelerator" "example" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,320 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlobalAcceleratorEndpointGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlobalAcceleratorEndpointGroupCreate,
+		Read:   resourceAwsGlobalAcceleratorEndpointGroupRead,
+		Update: resourceAwsGlobalAcceleratorEndpointGroupUpdate,
+		Delete: resourceAwsGlobalAcceleratorEndpointGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"listener_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"endpoint_group_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"endpoint_configuration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      100,
+							ValidateFunc: validation.IntBetween(0, 255),
+						},
+						"client_ip_preservation_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"health_check_interval_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(10, 30),
+			},
+			"health_check_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "/",
+			},
+			"health_check_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"health_check_protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  globalaccelerator.HealthCheckProtocolTcp,
+				ValidateFunc: validation.StringInSlice([]string{
+					globalaccelerator.HealthCheckProtocolTcp,
+					globalaccelerator.HealthCheckProtocolHttp,
+					globalaccelerator.HealthCheckProtocolHttps,
+				}, false),
+			},
+			"threshold_count": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3,
+			},
+			"traffic_dial_percentage": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  100,
+			},
+		},
+	}
+}
+
+func resourceAwsGlobalAcceleratorEndpointGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	opts := &globalaccelerator.CreateEndpointGroupInput{
+		EndpointGroupRegion:        aws.String(meta.(*AWSClient).region),
+		EndpointConfigurations:     resourceAwsGlobalAcceleratorEndpointGroupExpandEndpointConfigurations(d.Get("endpoint_configuration").(*schema.Set).List()),
+		HealthCheckIntervalSeconds: aws.Int64(int64(d.Get("health_check_interval_seconds").(int))),
+		HealthCheckPath:            aws.String(d.Get("health_check_path").(string)),
+		HealthCheckProtocol:        aws.String(d.Get("health_check_protocol").(string)),
+		IdempotencyToken:           aws.String(resource.UniqueId()),
+		ListenerArn:                aws.String(d.Get("listener_arn").(string)),
+		ThresholdCount:             aws.Int64(int64(d.Get("threshold_count").(int))),
+		TrafficDialPercentage:      aws.Float64(d.Get("traffic_dial_percentage").(float64)),
+	}
+
+	if v, ok := d.GetOk("endpoint_group_region"); ok {
+		opts.EndpointGroupRegion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("health_check_port"); ok {
+		opts.HealthCheckPort = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Create Global Accelerator endpoint group: %s", opts)
+
+	resp, err := conn.CreateEndpointGroup(opts)
+	if err != nil {
+		return fmt.Errorf("Error creating Global Accelerator endpoint group: %s", err)
+	}
+
+	d.SetId(*resp.EndpointGroup.EndpointGroupArn)
+
+	acceleratorArn := resourceAwsGlobalAcceleratorAcceleratorArnFromListenerArn(d.Get("listener_arn").(string))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{globalaccelerator.AcceleratorStatusInProgress},
+		Target:  []string{globalaccelerator.AcceleratorStatusDeployed},
+		Refresh: resourceAwsGlobalAcceleratorAcceleratorStateRefreshFunc(conn, acceleratorArn),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	log.Printf("[DEBUG] Waiting for Global Accelerator endpoint group (%s) availability", d.Id())
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for Global Accelerator endpoint group (%s) availability: %s", d.Id(), err)
+	}
+
+	return resourceAwsGlobalAcceleratorEndpointGroupRead(d, meta)
+}
+
+func resourceAwsGlobalAcceleratorEndpointGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	endpointGroup, err := resourceAwsGlobalAcceleratorEndpointGroupRetrieve(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("Error reading Global Accelerator endpoint group: %s", err)
+	}
+
+	if endpointGroup == nil {
+		log.Printf("[WARN] Global Accelerator endpoint group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("listener_arn", resourceAwsGlobalAcceleratorListenerArnFromEndpointGroupArn(d.Id()))
+	d.Set("endpoint_group_region", endpointGroup.EndpointGroupRegion)
+	d.Set("health_check_interval_seconds", endpointGroup.HealthCheckIntervalSeconds)
+	d.Set("health_check_path", endpointGroup.HealthCheckPath)
+	d.Set("health_check_port", endpointGroup.HealthCheckPort)
+	d.Set("health_check_protocol", endpointGroup.HealthCheckProtocol)
+	d.Set("threshold_count", endpointGroup.ThresholdCount)
+	d.Set("traffic_dial_percentage", endpointGroup.TrafficDialPercentage)
+
+	if err := d.Set("endpoint_configuration", resourceAwsGlobalAcceleratorEndpointGroupFlattenEndpointConfigurations(endpointGroup.EndpointDescriptions)); err != nil {
+		return fmt.Errorf("Error setting endpoint_configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlobalAcceleratorEndpointGroupRetrieve(conn *globalaccelerator.GlobalAccelerator, endpointGroupArn string) (*globalaccelerator.EndpointGroup, error) {
+	resp, err := conn.DescribeEndpointGroup(&globalaccelerator.DescribeEndpointGroupInput{
+		EndpointGroupArn: aws.String(endpointGroupArn),
+	})
+
+	if err != nil {
+		if isAWSErr(err, globalaccelerator.ErrCodeEndpointGroupNotFoundException, "") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return resp.EndpointGroup, nil
+}
+
+func resourceAwsGlobalAcceleratorEndpointGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	opts := &globalaccelerator.UpdateEndpointGroupInput{
+		EndpointGroupArn:           aws.String(d.Id()),
+		EndpointConfigurations:     resourceAwsGlobalAcceleratorEndpointGroupExpandEndpointConfigurations(d.Get("endpoint_configuration").(*schema.Set).List()),
+		HealthCheckIntervalSeconds: aws.Int64(int64(d.Get("health_check_interval_seconds").(int))),
+		HealthCheckPath:            aws.String(d.Get("health_check_path").(string)),
+		HealthCheckProtocol:        aws.String(d.Get("health_check_protocol").(string)),
+		ThresholdCount:             aws.Int64(int64(d.Get("threshold_count").(int))),
+		TrafficDialPercentage:      aws.Float64(d.Get("traffic_dial_percentage").(float64)),
+	}
+
+	if v, ok := d.GetOk("health_check_port"); ok {
+		opts.HealthCheckPort = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Update Global Accelerator endpoint group: %s", opts)
+
+	_, err := conn.UpdateEndpointGroup(opts)
+	if err != nil {
+		return fmt.Errorf("Error updating Global Accelerator endpoint group: %s", err)
+	}
+
+	acceleratorArn := resourceAwsGlobalAcceleratorAcceleratorArnFromListenerArn(d.Get("listener_arn").(string))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{globalaccelerator.AcceleratorStatusInProgress},
+		Target:  []string{globalaccelerator.AcceleratorStatusDeployed},
+		Refresh: resourceAwsGlobalAcceleratorAcceleratorStateRefreshFunc(conn, acceleratorArn),
+		Timeout: d.Timeout(schema.TimeoutUpdate),
+	}
+
+	log.Printf("[DEBUG] Waiting for Global Accelerator endpoint group (%s) availability", d.Id())
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for Global Accelerator endpoint group (%s) availability: %s", d.Id(), err)
+	}
+
+	return resourceAwsGlobalAcceleratorEndpointGroupRead(d, meta)
+}
+
+// resourceAwsGlobalAcceleratorAcceleratorArnFromListenerArn derives the
+// parent accelerator's ARN from a listener (or endpoint group) ARN, e.g.
+// "arn:aws:globalaccelerator::012345678901:accelerator/abcd1234/listener/efgh5678"
+// becomes "arn:aws:globalaccelerator::012345678901:accelerator/abcd1234".
+func resourceAwsGlobalAcceleratorAcceleratorArnFromListenerArn(arn string) string {
+	if i := strings.Index(arn, "/listener/"); i != -1 {
+		return arn[:i]
+	}
+
+	return arn
+}
+
+// resourceAwsGlobalAcceleratorListenerArnFromEndpointGroupArn derives the
+// parent listener's ARN from an endpoint group ARN, e.g.
+// "arn:aws:globalaccelerator::012345678901:accelerator/abcd1234/listener/efgh5678/endpoint-group/ijkl9012"
+// becomes "arn:aws:globalaccelerator::012345678901:accelerator/abcd1234/listener/efgh5678".
+func resourceAwsGlobalAcceleratorListenerArnFromEndpointGroupArn(arn string) string {
+	if i := strings.Index(arn, "/endpoint-group/"); i != -1 {
+		return arn[:i]
+	}
+
+	return arn
+}
+
+func resourceAwsGlobalAcceleratorEndpointGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	opts := &globalaccelerator.DeleteEndpointGroupInput{
+		EndpointGroupArn: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteEndpointGroup(opts)
+	if err != nil {
+		if isAWSErr(err, globalaccelerator.ErrCodeEndpointGroupNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Global Accelerator endpoint group: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlobalAcceleratorEndpointGroupExpandEndpointConfigurations(configurations []interface{}) []*globalaccelerator.EndpointConfiguration {
+	out := make([]*globalaccelerator.EndpointConfiguration, len(configurations))
+
+	for i, raw := range configurations {
+		configuration := raw.(map[string]interface{})
+		m := globalaccelerator.EndpointConfiguration{}
+
+		m.EndpointId = aws.String(configuration["endpoint_id"].(string))
+		m.Weight = aws.Int64(int64(configuration["weight"].(int)))
+		m.ClientIPPreservationEnabled = aws.Bool(configuration["client_ip_preservation_enabled"].(bool))
+
+		out[i] = &m
+	}
+
+	return out
+}
+
+func resourceAwsGlobalAcceleratorEndpointGroupFlattenEndpointConfigurations(configurations []*globalaccelerator.EndpointDescription) []interface{} {
+	out := make([]interface{}, len(configurations))
+
+	for i, configuration := range configurations {
+		m := make(map[string]interface{})
+
+		m["endpoint_id"] = aws.StringValue(configuration.EndpointId)
+		m["weight"] = aws.Int64Value(configuration.Weight)
+		m["client_ip_preservation_enabled"] = aws.BoolValue(configuration.ClientIPPreservationEnabled)
+
+		out[i] = m
+	}
+
+	return out
+}
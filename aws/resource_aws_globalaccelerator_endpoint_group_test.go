@@ -0,0 +1,104 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlobalAcceleratorEndpointGroup_basic(t *testing.T) {
+	var endpointGroup globalaccelerator.EndpointGroup
+	resourceName := "aws_globalaccelerator_endpoint_group.example"
+	rName := fmt.Sprintf("tf-testacc-ga-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlobalAcceleratorEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorEndpointGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorEndpointGroupExists(resourceName, &endpointGroup),
+					resource.TestCheckResourceAttr(resourceName, "health_check_protocol", globalaccelerator.HealthCheckProtocolTcp),
+					resource.TestCheckResourceAttr(resourceName, "traffic_dial_percentage", "100"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGlobalAcceleratorEndpointGroupExists(name string, endpointGroup *globalaccelerator.EndpointGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+		resp, err := resourceAwsGlobalAcceleratorEndpointGroupRetrieve(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp == nil {
+			return fmt.Errorf("Global Accelerator endpoint group not found")
+		}
+
+		*endpointGroup = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckGlobalAcceleratorEndpointGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_globalaccelerator_endpoint_group" {
+			continue
+		}
+
+		endpointGroup, err := resourceAwsGlobalAcceleratorEndpointGroupRetrieve(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if endpointGroup != nil {
+			return fmt.Errorf("Global Accelerator endpoint group still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccGlobalAcceleratorEndpointGroupConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_accelerator" "example" {
+  name = %[1]q
+}
+
+resource "aws_globalaccelerator_listener" "example" {
+  accelerator_arn = aws_globalaccelerator_accelerator.example.id
+  protocol        = "TCP"
+
+  port_range {
+    from_port = 80
+    to_port   = 80
+  }
+}
+
+resource "aws_globalaccelerator_endpoint_group" "example" {
+  listener_arn = aws_globalaccelerator_listener.example.id
+}
+`, rName)
+}
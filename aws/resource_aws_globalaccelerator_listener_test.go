@@ -0,0 +1,100 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlobalAcceleratorListener_basic(t *testing.T) {
+	var listener globalaccelerator.Listener
+	resourceName := "aws_globalaccelerator_listener.example"
+	rName := fmt.Sprintf("tf-testacc-ga-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlobalAcceleratorListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorListenerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorListenerExists(resourceName, &listener),
+					resource.TestCheckResourceAttr(resourceName, "protocol", globalaccelerator.ProtocolTcp),
+					resource.TestCheckResourceAttr(resourceName, "port_range.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGlobalAcceleratorListenerExists(name string, listener *globalaccelerator.Listener) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+		resp, err := resourceAwsGlobalAcceleratorListenerRetrieve(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp == nil {
+			return fmt.Errorf("Global Accelerator listener not found")
+		}
+
+		*listener = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckGlobalAcceleratorListenerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_globalaccelerator_listener" {
+			continue
+		}
+
+		listener, err := resourceAwsGlobalAcceleratorListenerRetrieve(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if listener != nil {
+			return fmt.Errorf("Global Accelerator listener still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccGlobalAcceleratorListenerConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_accelerator" "example" {
+  name = %[1]q
+}
+
+resource "aws_globalaccelerator_listener" "example" {
+  accelerator_arn = aws_globalaccelerator_accelerator.example.id
+  protocol        = "TCP"
+
+  port_range {
+    from_port = 80
+    to_port   = 80
+  }
+}
+`, rName)
+}